@@ -157,6 +157,7 @@ ForkStateDBSet=-1 #fork 6.2
 ForkLocalDBAccess=-1 #fork 6.2
 ForkBlockCheck=-1 #fork 6.2
 ForkBase58AddressCheck=-1 #fork 6.2
+ForkSchnorrSign=-1
 
 [fork.sub.coins]
 Enable=0
@@ -203,6 +204,10 @@ Enable=1600000
 ForkTerminatePart=1600000
 ForkUnfreezeIDX= -1 #fork 6.2
 
+[fork.sub.hello]
+#参考合约, 主网不开启, 测试网和本地测试使用代码中的默认配置开启
+Enable=-1
+
 [fork.sub.store-kvmvccmavl]
 ForkKvmvccmavl=-1 #fork 6.2
 `
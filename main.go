@@ -25,5 +25,16 @@ func main() {
 		debug.SetGCPercent(*percent)
 	}
 	types.S("cfg.bityuan", bityuan)
+	//bityuan export/import 离线导出导入区块
+	if flag.NArg() > 0 {
+		switch flag.Arg(0) {
+		case "export":
+			cli.RunExport("bityuan", flag.Args()[1:])
+			return
+		case "import":
+			cli.RunImport("bityuan", flag.Args()[1:])
+			return
+		}
+	}
 	cli.RunChain33("bityuan")
 }
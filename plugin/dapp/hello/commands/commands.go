@@ -0,0 +1,112 @@
+/*Package commands implement dapp client commands*/
+package commands
+
+import (
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	ptypes "github.com/bityuan/bityuan/plugin/dapp/hello/types/hello"
+	"github.com/spf13/cobra"
+)
+
+/*
+ * 实现合约对应客户端
+ */
+
+// Cmd hello client command
+func Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hello",
+		Short: "hello command",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		createGreetCmd(),
+		createClearCmd(),
+		queryGreetingCmd(),
+	)
+	return cmd
+}
+
+func createGreetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "greet",
+		Short: "create greet tx, set greeting message of the signer",
+		Run:   createGreet,
+	}
+	cmd.Flags().StringP("message", "m", "", "greeting message")
+	cmd.MarkFlagRequired("message")
+	return cmd
+}
+
+func createGreet(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	message, _ := cmd.Flags().GetString("message")
+
+	req := &ptypes.Greet{Message: message}
+	chain33Req := &rpctypes.CreateTxIn{
+		Execer:     paraName + ptypes.HelloX,
+		ActionName: ptypes.NameGreetAction,
+		Payload:    types.MustPBToJSON(req),
+	}
+	var res string
+	//通过框架rpc调用
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", chain33Req, &res)
+	//通过合约内部实现rpc调用
+	//ctx := jsonclient.NewRPCCtx(rpcLaddr, "hello.CreateRawGreetTx", req, &res)
+	ctx.RunWithoutMarshal()
+}
+
+func createClearCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clear",
+		Short: "create clear tx, clear greeting message of the signer",
+		Run:   createClear,
+	}
+	return cmd
+}
+
+func createClear(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+
+	chain33Req := &rpctypes.CreateTxIn{
+		Execer:     paraName + ptypes.HelloX,
+		ActionName: ptypes.NameClearAction,
+		Payload:    types.MustPBToJSON(&ptypes.Clear{}),
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", chain33Req, &res)
+	ctx.RunWithoutMarshal()
+}
+
+func queryGreetingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query",
+		Short: "query greeting message and greet count of address",
+		Run:   queryGreeting,
+	}
+	cmd.Flags().StringP("addr", "a", "", "account address")
+	cmd.MarkFlagRequired("addr")
+	return cmd
+}
+
+func queryGreeting(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	addr, _ := cmd.Flags().GetString("addr")
+
+	req := &ptypes.ReqGreeting{Addr: addr}
+	chain33Req := &rpctypes.Query4Jrpc{
+		Execer:   paraName + ptypes.HelloX,
+		FuncName: ptypes.FuncNameGetGreeting,
+		Payload:  types.MustPBToJSON(req),
+	}
+	var res ptypes.ReplyGreeting
+	//调用框架Query rpc接口
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", chain33Req, &res)
+	//调用合约内部rpc接口
+	//ctx := jsonclient.NewRPCCtx(rpcLaddr, "hello.GetGreeting", req, &res)
+	ctx.Run()
+}
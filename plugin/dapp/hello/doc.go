@@ -1 +1,12 @@
+// Package hello 是bityuan自有合约的参考实现, 目录结构与chain33-tool gendapp生成的结构保持一致,
+// 编写新合约时可以直接以此为模板.
+// 主网配置中合约不开启(fork.sub.hello Enable=-1), 只用于测试网和本地测试.
+//
+// 合约提供了2类操作
+//  1. Greet: 设置交易发送者的问候语, 问候语存于statedb, 同时在localdb中累计该地址的问候次数
+//  1. Clear: 清除交易发送者的问候语, 不影响累计问候次数
+//
+// 查询接口
+//  1. GetGreeting: 查询地址当前的问候语及累计问候次数, 可通过Chain33.Query, hello.GetGreeting(json rpc)
+//     及grpc的hello服务调用
 package hello
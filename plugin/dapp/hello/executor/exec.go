@@ -0,0 +1,53 @@
+package executor
+
+import (
+	"github.com/33cn/chain33/types"
+	ptypes "github.com/bityuan/bityuan/plugin/dapp/hello/types/hello"
+)
+
+/*
+ * 实现交易的链上执行接口
+ * 关键数据上链（statedb）并生成交易回执（log）
+ */
+
+func (c *hello) Exec_Greet(payload *ptypes.Greet, tx *types.Transaction, index int) (*types.Receipt, error) {
+	addr := tx.From()
+	prev, err := getGreeting(c.GetStateDB(), addr)
+	if err != nil && err != types.ErrNotFound {
+		elog.Error("Exec_Greet", "addr", addr, "getGreeting", err)
+		return nil, err
+	}
+	curr := &ptypes.Greeting{
+		Addr:    addr,
+		Message: payload.Message,
+		Height:  c.GetHeight(),
+	}
+	greetLog := &ptypes.ReceiptGreet{Addr: addr, Prev: prev, Curr: curr}
+	receipt := &types.Receipt{
+		Ty:   types.ExecOk,
+		KV:   []*types.KeyValue{{Key: greetingKey(addr), Value: types.Encode(curr)}},
+		Logs: []*types.ReceiptLog{{Ty: ptypes.TyGreetLog, Log: types.Encode(greetLog)}},
+	}
+	return receipt, nil
+}
+
+func (c *hello) Exec_Clear(payload *ptypes.Clear, tx *types.Transaction, index int) (*types.Receipt, error) {
+	addr := tx.From()
+	prev, err := getGreeting(c.GetStateDB(), addr)
+	if err == types.ErrNotFound || (err == nil && prev.Message == "") {
+		return nil, ptypes.ErrGreetingNotFound
+	}
+	if err != nil {
+		elog.Error("Exec_Clear", "addr", addr, "getGreeting", err)
+		return nil, err
+	}
+	//statedb中的数据不做删除，只保留地址和清除时的高度
+	curr := &ptypes.Greeting{Addr: addr, Height: c.GetHeight()}
+	clearLog := &ptypes.ReceiptClear{Addr: addr, Prev: prev}
+	receipt := &types.Receipt{
+		Ty:   types.ExecOk,
+		KV:   []*types.KeyValue{{Key: greetingKey(addr), Value: types.Encode(curr)}},
+		Logs: []*types.ReceiptLog{{Ty: ptypes.TyClearLog, Log: types.Encode(clearLog)}},
+	}
+	return receipt, nil
+}
@@ -0,0 +1,30 @@
+package executor
+
+import (
+	"github.com/33cn/chain33/types"
+	ptypes "github.com/bityuan/bityuan/plugin/dapp/hello/types/hello"
+)
+
+/*
+ * 实现区块回退时本地执行的数据清除
+ */
+
+func (c *hello) ExecDelLocal_Greet(payload *ptypes.Greet, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	addr := tx.From()
+	count, err := getGreetCount(c.GetLocalDB(), addr)
+	if err != nil {
+		elog.Error("ExecDelLocal_Greet", "addr", addr, "getGreetCount", err)
+		return nil, err
+	}
+	count.Count--
+	value := types.Encode(count)
+	if count.Count <= 0 {
+		value = nil
+	}
+	dbSet := &types.LocalDBSet{KV: []*types.KeyValue{{Key: greetCountKey(addr), Value: value}}}
+	return dbSet, nil
+}
+
+func (c *hello) ExecDelLocal_Clear(payload *ptypes.Clear, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return &types.LocalDBSet{}, nil
+}
@@ -0,0 +1,28 @@
+package executor
+
+import (
+	"github.com/33cn/chain33/types"
+	ptypes "github.com/bityuan/bityuan/plugin/dapp/hello/types/hello"
+)
+
+/*
+ * 实现交易相关数据本地执行，数据不上链
+ * 非关键数据，本地存储(localDB), 用于辅助查询，效率高
+ */
+
+func (c *hello) ExecLocal_Greet(payload *ptypes.Greet, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	addr := tx.From()
+	count, err := getGreetCount(c.GetLocalDB(), addr)
+	if err != nil {
+		elog.Error("ExecLocal_Greet", "addr", addr, "getGreetCount", err)
+		return nil, err
+	}
+	count.Count++
+	dbSet := &types.LocalDBSet{KV: []*types.KeyValue{{Key: greetCountKey(addr), Value: types.Encode(count)}}}
+	return dbSet, nil
+}
+
+func (c *hello) ExecLocal_Clear(payload *ptypes.Clear, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	//清除问候语不影响累计问候次数
+	return &types.LocalDBSet{}, nil
+}
@@ -0,0 +1,80 @@
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	ptypes "github.com/bityuan/bityuan/plugin/dapp/hello/types/hello"
+)
+
+/*
+ * 执行器相关定义
+ * 重载基类相关接口
+ */
+
+var (
+	//日志
+	elog = log.New("module", "hello.executor")
+)
+
+var driverName = ptypes.HelloX
+
+func init() {
+	ety := types.LoadExecutorType(driverName)
+	ety.InitFuncList(types.ListMethod(&hello{}))
+}
+
+// Init register dapp
+func Init(name string, sub []byte) {
+	drivers.Register(GetName(), newHello, types.GetDappFork(driverName, "Enable"))
+}
+
+type hello struct {
+	drivers.DriverBase
+}
+
+func newHello() drivers.Driver {
+	t := &hello{}
+	t.SetChild(t)
+	t.SetExecutorType(types.LoadExecutorType(driverName))
+	return t
+}
+
+// GetName get driver name
+func GetName() string {
+	return newHello().GetName()
+}
+
+func (*hello) GetDriverName() string {
+	return driverName
+}
+
+// CheckTx 实现自定义检验交易接口，供框架调用
+func (*hello) CheckTx(tx *types.Transaction, index int) error {
+	action := &ptypes.HelloAction{}
+	err := types.Decode(tx.GetPayload(), action)
+	if err != nil {
+		elog.Error("CheckTx", "DecodeActionErr", err)
+		return types.ErrDecode
+	}
+	//这里只做问候语长度检查
+	if action.Ty == ptypes.TyGreetAction {
+		greet := action.GetGreet()
+		if greet == nil {
+			return types.ErrInvalidParam
+		}
+		if len(greet.Message) == 0 {
+			return ptypes.ErrGreetingEmpty
+		}
+		if len(greet.Message) > ptypes.MaxGreetingLength {
+			elog.Error("CheckTx", "Err", ptypes.ErrGreetingTooLong, "size", len(greet.Message))
+			return ptypes.ErrGreetingTooLong
+		}
+	}
+	return nil
+}
+
+// CheckReceiptExecOk 只有执行成功的交易才进行本地数据处理
+func (*hello) CheckReceiptExecOk() bool {
+	return true
+}
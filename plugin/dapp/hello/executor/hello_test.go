@@ -0,0 +1,169 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	ptypes "github.com/bityuan/bityuan/plugin/dapp/hello/types/hello"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEnv struct {
+	t       *testing.T
+	exec    *hello
+	stateDB dbm.KV
+	localDB dbm.KVDB
+	height  int64
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	stateDB, _ := dbm.NewGoMemDB("hellostate", "", 128)
+	ldb, _ := dbm.NewGoMemDB("hellolocal", "", 128)
+	localDB := dbm.NewKVDB(ldb)
+	exec := newHello().(*hello)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(localDB)
+	return &testEnv{t: t, exec: exec, stateDB: stateDB, localDB: localDB}
+}
+
+// execTx 模拟框架执行交易, 依次调用Exec和ExecLocal, 并将kv写入对应db
+func (env *testEnv) execTx(tx *types.Transaction) (*types.Receipt, error) {
+	env.height++
+	env.exec.SetEnv(env.height, 1539918074+env.height, 1)
+	if err := env.exec.CheckTx(tx, 0); err != nil {
+		return nil, err
+	}
+	receipt, err := env.exec.Exec(tx, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, kv := range receipt.KV {
+		require.Nil(env.t, env.stateDB.Set(kv.Key, kv.Value))
+	}
+	set, err := env.exec.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, 0)
+	require.Nil(env.t, err)
+	for _, kv := range set.KV {
+		require.Nil(env.t, env.localDB.Set(kv.Key, kv.Value))
+	}
+	return receipt, nil
+}
+
+// delTx 模拟区块回退
+func (env *testEnv) delTx(tx *types.Transaction, receipt *types.Receipt) {
+	set, err := env.exec.ExecDelLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, 0)
+	require.Nil(env.t, err)
+	for _, kv := range set.KV {
+		require.Nil(env.t, env.localDB.Set(kv.Key, kv.Value))
+	}
+}
+
+func (env *testEnv) query(addr string) (*ptypes.ReplyGreeting, error) {
+	msg, err := env.exec.Query(ptypes.FuncNameGetGreeting, types.Encode(&ptypes.ReqGreeting{Addr: addr}))
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*ptypes.ReplyGreeting), nil
+}
+
+func signTx(tx *types.Transaction, priv crypto.PrivKey) *types.Transaction {
+	tx.Sign(types.SECP256K1, priv)
+	return tx
+}
+
+func TestGreetAndClear(t *testing.T) {
+	env := newTestEnv(t)
+	addr, priv := util.Genaddress()
+
+	_, err := env.query(addr)
+	assert.Equal(t, types.ErrNotFound, err)
+
+	tx, err := ptypes.CreateGreetTx(&ptypes.Greet{Message: "hello bityuan"})
+	require.Nil(t, err)
+	receipt, err := env.execTx(signTx(tx, priv))
+	require.Nil(t, err)
+	assert.Equal(t, int32(types.ExecOk), receipt.Ty)
+	require.Equal(t, 1, len(receipt.Logs))
+	assert.Equal(t, int32(ptypes.TyGreetLog), receipt.Logs[0].Ty)
+	var greetLog ptypes.ReceiptGreet
+	require.Nil(t, types.Decode(receipt.Logs[0].Log, &greetLog))
+	assert.Nil(t, greetLog.Prev)
+	assert.Equal(t, "hello bityuan", greetLog.Curr.Message)
+
+	reply, err := env.query(addr)
+	require.Nil(t, err)
+	assert.Equal(t, "hello bityuan", reply.Greeting.Message)
+	assert.Equal(t, int64(1), reply.Greeting.Height)
+	assert.Equal(t, int64(1), reply.Count)
+
+	tx, err = ptypes.CreateGreetTx(&ptypes.Greet{Message: "hi"})
+	require.Nil(t, err)
+	greetTx := signTx(tx, priv)
+	greetReceipt, err := env.execTx(greetTx)
+	require.Nil(t, err)
+	require.Nil(t, types.Decode(greetReceipt.Logs[0].Log, &greetLog))
+	assert.Equal(t, "hello bityuan", greetLog.Prev.Message)
+	reply, err = env.query(addr)
+	require.Nil(t, err)
+	assert.Equal(t, "hi", reply.Greeting.Message)
+	assert.Equal(t, int64(2), reply.Count)
+
+	//回退本地数据
+	env.delTx(greetTx, greetReceipt)
+	reply, err = env.query(addr)
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), reply.Count)
+
+	tx, err = ptypes.CreateClearTx(&ptypes.Clear{})
+	require.Nil(t, err)
+	receipt, err = env.execTx(signTx(tx, priv))
+	require.Nil(t, err)
+	assert.Equal(t, int32(ptypes.TyClearLog), receipt.Logs[0].Ty)
+	reply, err = env.query(addr)
+	require.Nil(t, err)
+	assert.Nil(t, reply.Greeting)
+	assert.Equal(t, int64(1), reply.Count)
+
+	//重复清除
+	tx, err = ptypes.CreateClearTx(&ptypes.Clear{})
+	require.Nil(t, err)
+	_, err = env.execTx(signTx(tx, priv))
+	assert.Equal(t, ptypes.ErrGreetingNotFound, err)
+}
+
+func TestCheckTx(t *testing.T) {
+	env := newTestEnv(t)
+	_, priv := util.Genaddress()
+
+	_, err := ptypes.CreateGreetTx(&ptypes.Greet{})
+	assert.Equal(t, ptypes.ErrGreetingEmpty, err)
+	long := make([]byte, ptypes.MaxGreetingLength+1)
+	for i := range long {
+		long[i] = 'a'
+	}
+	_, err = ptypes.CreateGreetTx(&ptypes.Greet{Message: string(long)})
+	assert.Equal(t, ptypes.ErrGreetingTooLong, err)
+
+	//绕过交易构造函数, 由CheckTx拦截
+	action := &ptypes.HelloAction{
+		Ty:    ptypes.TyGreetAction,
+		Value: &ptypes.HelloAction_Greet{Greet: &ptypes.Greet{Message: string(long)}},
+	}
+	tx := &types.Transaction{Execer: []byte(ptypes.HelloX), Payload: types.Encode(action)}
+	tx, err = types.FormatTx(ptypes.HelloX, tx)
+	require.Nil(t, err)
+	_, err = env.execTx(signTx(tx, priv))
+	assert.Equal(t, ptypes.ErrGreetingTooLong, err)
+
+	tx = &types.Transaction{Execer: []byte(ptypes.HelloX), Payload: []byte("invalid payload")}
+	assert.Equal(t, types.ErrDecode, env.exec.CheckTx(tx, 0))
+}
+
+func TestQueryInvalidAddr(t *testing.T) {
+	env := newTestEnv(t)
+	_, err := env.query("invalid address")
+	assert.Equal(t, types.ErrInvalidAddress, err)
+}
@@ -0,0 +1,62 @@
+package executor
+
+import (
+	"fmt"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ptypes "github.com/bityuan/bityuan/plugin/dapp/hello/types/hello"
+)
+
+/*
+ * 用户合约存取kv数据时，key值前缀需要满足一定规范
+ * 即key = keyPrefix + userKey
+ * 需要字段前缀查询时，使用’-‘作为分割符号
+ */
+
+var (
+	//KeyPrefixStateDB state db key必须前缀
+	KeyPrefixStateDB = "mavl-hello-"
+	//KeyPrefixLocalDB local db的key必须前缀
+	KeyPrefixLocalDB = "LODB-hello-"
+)
+
+// greetingKey 地址对应问候语的statedb key
+func greetingKey(addr string) []byte {
+	return []byte(fmt.Sprintf("%sgreeting-%s", KeyPrefixStateDB, addr))
+}
+
+// greetCountKey 地址对应问候次数的localdb key
+func greetCountKey(addr string) []byte {
+	return []byte(fmt.Sprintf("%scount-%s", KeyPrefixLocalDB, addr))
+}
+
+func getGreeting(db dbm.KV, addr string) (*ptypes.Greeting, error) {
+	value, err := db.Get(greetingKey(addr))
+	if err != nil {
+		return nil, err
+	}
+	var greeting ptypes.Greeting
+	err = types.Decode(value, &greeting)
+	if err != nil {
+		return nil, err
+	}
+	return &greeting, nil
+}
+
+func getGreetCount(db dbm.KVDB, addr string) (*ptypes.GreetCount, error) {
+	count := &ptypes.GreetCount{Addr: addr}
+	value, err := db.Get(greetCountKey(addr))
+	//此处需要注意，目前db接口，获取key未找到记录，返回空时候也带一个notFound错误，需要特殊处理，而不是直接返回错误
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	if err == types.ErrNotFound {
+		return count, nil
+	}
+	err = types.Decode(value, count)
+	if err != nil {
+		return nil, err
+	}
+	return count, nil
+}
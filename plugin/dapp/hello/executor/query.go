@@ -0,0 +1,35 @@
+package executor
+
+import (
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	ptypes "github.com/bityuan/bityuan/plugin/dapp/hello/types/hello"
+)
+
+/*
+ * 实现合约查询接口
+ * 查询函数名需满足Query_+FuncName格式，才能被框架注册
+ */
+
+// Query_GetGreeting 查询地址当前的问候语及累计问候次数
+func (c *hello) Query_GetGreeting(in *ptypes.ReqGreeting) (types.Message, error) {
+	if in == nil || address.CheckAddress(in.Addr) != nil {
+		return nil, types.ErrInvalidAddress
+	}
+	greeting, err := getGreeting(c.GetStateDB(), in.Addr)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	count, err := getGreetCount(c.GetLocalDB(), in.Addr)
+	if err != nil {
+		return nil, err
+	}
+	if greeting == nil && count.Count == 0 {
+		return nil, types.ErrNotFound
+	}
+	reply := &ptypes.ReplyGreeting{Count: count.Count}
+	if greeting != nil && greeting.Message != "" {
+		reply.Greeting = greeting
+	}
+	return reply, nil
+}
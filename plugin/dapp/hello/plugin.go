@@ -0,0 +1,23 @@
+package hello
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/bityuan/bityuan/plugin/dapp/hello/commands"
+	"github.com/bityuan/bityuan/plugin/dapp/hello/executor"
+	"github.com/bityuan/bityuan/plugin/dapp/hello/rpc"
+	ptypes "github.com/bityuan/bityuan/plugin/dapp/hello/types/hello"
+)
+
+/*
+ * 初始化dapp相关的组件
+ */
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     ptypes.HelloX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.Cmd,
+		RPC:      rpc.Init,
+	})
+}
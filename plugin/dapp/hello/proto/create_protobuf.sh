@@ -0,0 +1,3 @@
+#!/bin/sh
+# proto生成命令，将pb.go文件生成到types/hello目录下
+protoc --go_out=plugins=grpc:../types/hello ./*.proto
@@ -0,0 +1,67 @@
+package rpc
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/33cn/chain33/types"
+	ptypes "github.com/bityuan/bityuan/plugin/dapp/hello/types/hello"
+)
+
+/*
+ * 实现json rpc和grpc service接口
+ * json rpc用Jrpc结构作为接收实例
+ * grpc使用channelClient结构作为接收实例
+ */
+
+// GetGreeting 查询地址当前的问候语, 通过框架Query接口间接调用执行器的Query_GetGreeting
+func (c *channelClient) GetGreeting(ctx context.Context, in *ptypes.ReqGreeting) (*ptypes.ReplyGreeting, error) {
+	msg, err := c.Query(ptypes.HelloX, ptypes.FuncNameGetGreeting, in)
+	if err != nil {
+		return nil, err
+	}
+	if reply, ok := msg.(*ptypes.ReplyGreeting); ok {
+		return reply, nil
+	}
+	return nil, types.ErrTypeAsset
+}
+
+// GetGreeting 查询地址当前的问候语
+func (j *Jrpc) GetGreeting(in *ptypes.ReqGreeting, result *interface{}) error {
+	if in == nil {
+		return types.ErrInvalidParam
+	}
+	reply, err := j.cli.GetGreeting(context.Background(), in)
+	if err != nil {
+		return err
+	}
+	*result = reply
+	return nil
+}
+
+// CreateRawGreetTx 创建设置问候语的原始交易
+func (j *Jrpc) CreateRawGreetTx(in *ptypes.Greet, result *interface{}) error {
+	if in == nil {
+		return types.ErrInvalidParam
+	}
+	data, err := types.CallCreateTx(types.ExecName(ptypes.HelloX), ptypes.NameGreetAction, in)
+	if err != nil {
+		return err
+	}
+	//创建交易通常返回十六进制格式原数据
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawClearTx 创建清除问候语的原始交易
+func (j *Jrpc) CreateRawClearTx(in *ptypes.Clear, result *interface{}) error {
+	if in == nil {
+		return types.ErrInvalidParam
+	}
+	data, err := types.CallCreateTx(types.ExecName(ptypes.HelloX), ptypes.NameClearAction, in)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
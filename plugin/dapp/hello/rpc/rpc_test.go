@@ -0,0 +1,93 @@
+package rpc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	commonlog "github.com/33cn/chain33/common/log"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util/testnode"
+	ptypes "github.com/bityuan/bityuan/plugin/dapp/hello/types/hello"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	_ "github.com/33cn/chain33/system"
+	_ "github.com/bityuan/bityuan/plugin"
+)
+
+func init() {
+	commonlog.SetLogLevel("error")
+}
+
+func TestHelloRPC(t *testing.T) {
+	mocker := testnode.New("--free--", nil)
+	defer mocker.Close()
+	mocker.Listen()
+	require.Nil(t, mocker.SendHot())
+	jrpc := mocker.GetJSONC()
+	addr := mocker.GetHotAddress()
+
+	//通过合约rpc创建交易
+	var txhex string
+	err := jrpc.Call("hello.CreateRawGreetTx", &ptypes.Greet{Message: "hello bityuan"}, &txhex)
+	require.Nil(t, err)
+	hash, err := mocker.SendAndSign(mocker.GetHotKey(), txhex)
+	require.Nil(t, err)
+	detail, err := mocker.WaitTx(hash)
+	require.Nil(t, err)
+	assert.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+
+	var reply ptypes.ReplyGreeting
+	err = jrpc.Call("hello.GetGreeting", &ptypes.ReqGreeting{Addr: addr}, &reply)
+	require.Nil(t, err)
+	assert.Equal(t, "hello bityuan", reply.Greeting.Message)
+	assert.Equal(t, int64(1), reply.Count)
+
+	//通过框架rpc创建交易及查询
+	req := &rpctypes.CreateTxIn{
+		Execer:     ptypes.HelloX,
+		ActionName: ptypes.NameClearAction,
+		Payload:    types.MustPBToJSON(&ptypes.Clear{}),
+	}
+	err = jrpc.Call("Chain33.CreateTransaction", req, &txhex)
+	require.Nil(t, err)
+	hash, err = mocker.SendAndSign(mocker.GetHotKey(), txhex)
+	require.Nil(t, err)
+	detail, err = mocker.WaitTx(hash)
+	require.Nil(t, err)
+	assert.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+
+	query := &rpctypes.Query4Jrpc{
+		Execer:   ptypes.HelloX,
+		FuncName: ptypes.FuncNameGetGreeting,
+		Payload:  types.MustPBToJSON(&ptypes.ReqGreeting{Addr: addr}),
+	}
+	reply = ptypes.ReplyGreeting{}
+	err = jrpc.Call("Chain33.Query", query, &reply)
+	require.Nil(t, err)
+	assert.Nil(t, reply.Greeting)
+	assert.Equal(t, int64(1), reply.Count)
+
+	//再次清除, 交易执行失败
+	err = jrpc.Call("hello.CreateRawClearTx", &ptypes.Clear{}, &txhex)
+	require.Nil(t, err)
+	hash, err = mocker.SendAndSign(mocker.GetHotKey(), txhex)
+	require.Nil(t, err)
+	detail, err = mocker.WaitTx(hash)
+	require.Nil(t, err)
+	assert.Equal(t, int32(types.ExecPack), detail.Receipt.Ty)
+
+	//grpc查询
+	conn, err := grpc.Dial(mocker.GetCfg().RPC.GrpcBindAddr, grpc.WithInsecure())
+	require.Nil(t, err)
+	defer conn.Close()
+	greeting, err := ptypes.NewHelloClient(conn).GetGreeting(context.Background(), &ptypes.ReqGreeting{Addr: addr})
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), greeting.Count)
+
+	err = jrpc.Call("hello.GetGreeting", &ptypes.ReqGreeting{Addr: common.ToHex(hash)}, &reply)
+	assert.NotNil(t, err)
+}
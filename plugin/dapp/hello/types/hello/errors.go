@@ -0,0 +1,12 @@
+package hello
+
+import "errors"
+
+var (
+	// ErrGreetingEmpty 问候语为空
+	ErrGreetingEmpty = errors.New("ErrGreetingEmpty")
+	// ErrGreetingTooLong 问候语超过最大长度
+	ErrGreetingTooLong = errors.New("ErrGreetingTooLong")
+	// ErrGreetingNotFound 该地址未设置问候语
+	ErrGreetingNotFound = errors.New("ErrGreetingNotFound")
+)
@@ -0,0 +1,145 @@
+package hello
+
+import (
+	"encoding/json"
+	"reflect"
+
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/types"
+)
+
+/*
+ * 交易相关类型定义
+ * 交易action通常有对应的log结构，用于交易回执日志记录
+ * 每一种action和log需要用id数值和name名称加以区分
+ */
+
+// action类型id和name，这些常量可以自定义修改
+const (
+	TyUnknowAction = iota + 100
+	TyGreetAction
+	TyClearAction
+
+	NameGreetAction = "Greet"
+	NameClearAction = "Clear"
+)
+
+// log类型id值
+const (
+	TyUnknownLog = iota + 100
+	TyGreetLog
+	TyClearLog
+)
+
+// 查询方法名
+const (
+	FuncNameGetGreeting = "GetGreeting"
+)
+
+// MaxGreetingLength 问候语最大字节数
+const MaxGreetingLength = 128
+
+var (
+	//HelloX 执行器名称定义
+	HelloX = "hello"
+	//定义actionMap
+	actionMap = map[string]int32{
+		NameGreetAction: TyGreetAction,
+		NameClearAction: TyClearAction,
+	}
+	//定义log的id和具体log类型及名称，填入具体自定义log类型
+	logMap = map[int64]*types.LogInfo{
+		TyGreetLog: {Ty: reflect.TypeOf(ReceiptGreet{}), Name: "LogGreet"},
+		TyClearLog: {Ty: reflect.TypeOf(ReceiptClear{}), Name: "LogClear"},
+	}
+	tlog = log.New("module", "hello.types")
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, []byte(HelloX))
+	types.RegistorExecutor(HelloX, newType())
+	//注册合约启用高度
+	types.RegisterDappFork(HelloX, "Enable", 0)
+}
+
+type helloType struct {
+	types.ExecTypeBase
+}
+
+func newType() *helloType {
+	c := &helloType{}
+	c.SetChild(c)
+	return c
+}
+
+// GetPayload 获取合约action结构
+func (t *helloType) GetPayload() types.Message {
+	return &HelloAction{}
+}
+
+// GetTypeMap 获取合约action的id和name信息
+func (t *helloType) GetTypeMap() map[string]int32 {
+	return actionMap
+}
+
+// GetLogMap 获取合约log相关信息
+func (t *helloType) GetLogMap() map[int64]*types.LogInfo {
+	return logMap
+}
+
+// CreateTx 重载基类接口，实现本合约交易创建，供框架调用
+func (t *helloType) CreateTx(action string, message json.RawMessage) (*types.Transaction, error) {
+	if action == NameGreetAction {
+		var param Greet
+		err := types.JSONToPB(message, &param)
+		if err != nil {
+			tlog.Error("CreateTx", "action", action, "UnmarshalErr", err)
+			return nil, types.ErrInvalidParam
+		}
+		return CreateGreetTx(&param)
+	} else if action == NameClearAction {
+		var param Clear
+		err := types.JSONToPB(message, &param)
+		if err != nil {
+			tlog.Error("CreateTx", "action", action, "UnmarshalErr", err)
+			return nil, types.ErrInvalidParam
+		}
+		return CreateClearTx(&param)
+	}
+	return nil, types.ErrNotSupport
+}
+
+// CreateGreetTx 创建设置问候语交易
+func CreateGreetTx(param *Greet) (*types.Transaction, error) {
+	if param == nil {
+		return nil, types.ErrInvalidParam
+	}
+	if len(param.Message) == 0 {
+		return nil, ErrGreetingEmpty
+	}
+	if len(param.Message) > MaxGreetingLength {
+		return nil, ErrGreetingTooLong
+	}
+	action := &HelloAction{
+		Ty:    TyGreetAction,
+		Value: &HelloAction_Greet{Greet: param},
+	}
+	return createTx(action)
+}
+
+// CreateClearTx 创建清除问候语交易
+func CreateClearTx(param *Clear) (*types.Transaction, error) {
+	if param == nil {
+		param = &Clear{}
+	}
+	action := &HelloAction{
+		Ty:    TyClearAction,
+		Value: &HelloAction_Clear{Clear: param},
+	}
+	return createTx(action)
+}
+
+func createTx(action *HelloAction) (*types.Transaction, error) {
+	tx := &types.Transaction{Payload: types.Encode(action)}
+	return types.FormatTx(types.ExecName(HelloX), tx)
+}
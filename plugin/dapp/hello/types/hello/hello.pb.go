@@ -0,0 +1,654 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: hello.proto
+
+package hello
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// hello 合约交易行为总类型
+type HelloAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*HelloAction_Greet
+	//	*HelloAction_Clear
+	Value                isHelloAction_Value `protobuf_oneof:"value"`
+	Ty                   int32               `protobuf:"varint,3,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *HelloAction) Reset()         { *m = HelloAction{} }
+func (m *HelloAction) String() string { return proto.CompactTextString(m) }
+func (*HelloAction) ProtoMessage()    {}
+func (*HelloAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61ef911816e0a8ce, []int{0}
+}
+
+func (m *HelloAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HelloAction.Unmarshal(m, b)
+}
+func (m *HelloAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HelloAction.Marshal(b, m, deterministic)
+}
+func (m *HelloAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelloAction.Merge(m, src)
+}
+func (m *HelloAction) XXX_Size() int {
+	return xxx_messageInfo_HelloAction.Size(m)
+}
+func (m *HelloAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_HelloAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HelloAction proto.InternalMessageInfo
+
+type isHelloAction_Value interface {
+	isHelloAction_Value()
+}
+
+type HelloAction_Greet struct {
+	Greet *Greet `protobuf:"bytes,1,opt,name=greet,proto3,oneof"`
+}
+
+type HelloAction_Clear struct {
+	Clear *Clear `protobuf:"bytes,2,opt,name=clear,proto3,oneof"`
+}
+
+func (*HelloAction_Greet) isHelloAction_Value() {}
+
+func (*HelloAction_Clear) isHelloAction_Value() {}
+
+func (m *HelloAction) GetValue() isHelloAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *HelloAction) GetGreet() *Greet {
+	if x, ok := m.GetValue().(*HelloAction_Greet); ok {
+		return x.Greet
+	}
+	return nil
+}
+
+func (m *HelloAction) GetClear() *Clear {
+	if x, ok := m.GetValue().(*HelloAction_Clear); ok {
+		return x.Clear
+	}
+	return nil
+}
+
+func (m *HelloAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*HelloAction) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _HelloAction_OneofMarshaler, _HelloAction_OneofUnmarshaler, _HelloAction_OneofSizer, []interface{}{
+		(*HelloAction_Greet)(nil),
+		(*HelloAction_Clear)(nil),
+	}
+}
+
+func _HelloAction_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*HelloAction)
+	// value
+	switch x := m.Value.(type) {
+	case *HelloAction_Greet:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Greet); err != nil {
+			return err
+		}
+	case *HelloAction_Clear:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Clear); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("HelloAction.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _HelloAction_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*HelloAction)
+	switch tag {
+	case 1: // value.greet
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Greet)
+		err := b.DecodeMessage(msg)
+		m.Value = &HelloAction_Greet{msg}
+		return true, err
+	case 2: // value.clear
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Clear)
+		err := b.DecodeMessage(msg)
+		m.Value = &HelloAction_Clear{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _HelloAction_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*HelloAction)
+	// value
+	switch x := m.Value.(type) {
+	case *HelloAction_Greet:
+		s := proto.Size(x.Greet)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *HelloAction_Clear:
+		s := proto.Size(x.Clear)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// 设置问候语
+type Greet struct {
+	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Greet) Reset()         { *m = Greet{} }
+func (m *Greet) String() string { return proto.CompactTextString(m) }
+func (*Greet) ProtoMessage()    {}
+func (*Greet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61ef911816e0a8ce, []int{1}
+}
+
+func (m *Greet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Greet.Unmarshal(m, b)
+}
+func (m *Greet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Greet.Marshal(b, m, deterministic)
+}
+func (m *Greet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Greet.Merge(m, src)
+}
+func (m *Greet) XXX_Size() int {
+	return xxx_messageInfo_Greet.Size(m)
+}
+func (m *Greet) XXX_DiscardUnknown() {
+	xxx_messageInfo_Greet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Greet proto.InternalMessageInfo
+
+func (m *Greet) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// 清除问候语
+type Clear struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Clear) Reset()         { *m = Clear{} }
+func (m *Clear) String() string { return proto.CompactTextString(m) }
+func (*Clear) ProtoMessage()    {}
+func (*Clear) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61ef911816e0a8ce, []int{2}
+}
+
+func (m *Clear) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Clear.Unmarshal(m, b)
+}
+func (m *Clear) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Clear.Marshal(b, m, deterministic)
+}
+func (m *Clear) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clear.Merge(m, src)
+}
+func (m *Clear) XXX_Size() int {
+	return xxx_messageInfo_Clear.Size(m)
+}
+func (m *Clear) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clear.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Clear proto.InternalMessageInfo
+
+// 问候语记录, 存于statedb
+type Greeting struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Height               int64    `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Greeting) Reset()         { *m = Greeting{} }
+func (m *Greeting) String() string { return proto.CompactTextString(m) }
+func (*Greeting) ProtoMessage()    {}
+func (*Greeting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61ef911816e0a8ce, []int{3}
+}
+
+func (m *Greeting) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Greeting.Unmarshal(m, b)
+}
+func (m *Greeting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Greeting.Marshal(b, m, deterministic)
+}
+func (m *Greeting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Greeting.Merge(m, src)
+}
+func (m *Greeting) XXX_Size() int {
+	return xxx_messageInfo_Greeting.Size(m)
+}
+func (m *Greeting) XXX_DiscardUnknown() {
+	xxx_messageInfo_Greeting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Greeting proto.InternalMessageInfo
+
+func (m *Greeting) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *Greeting) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *Greeting) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type ReceiptGreet struct {
+	Addr                 string    `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Prev                 *Greeting `protobuf:"bytes,2,opt,name=prev,proto3" json:"prev,omitempty"`
+	Curr                 *Greeting `protobuf:"bytes,3,opt,name=curr,proto3" json:"curr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ReceiptGreet) Reset()         { *m = ReceiptGreet{} }
+func (m *ReceiptGreet) String() string { return proto.CompactTextString(m) }
+func (*ReceiptGreet) ProtoMessage()    {}
+func (*ReceiptGreet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61ef911816e0a8ce, []int{4}
+}
+
+func (m *ReceiptGreet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptGreet.Unmarshal(m, b)
+}
+func (m *ReceiptGreet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptGreet.Marshal(b, m, deterministic)
+}
+func (m *ReceiptGreet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptGreet.Merge(m, src)
+}
+func (m *ReceiptGreet) XXX_Size() int {
+	return xxx_messageInfo_ReceiptGreet.Size(m)
+}
+func (m *ReceiptGreet) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptGreet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptGreet proto.InternalMessageInfo
+
+func (m *ReceiptGreet) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReceiptGreet) GetPrev() *Greeting {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptGreet) GetCurr() *Greeting {
+	if m != nil {
+		return m.Curr
+	}
+	return nil
+}
+
+type ReceiptClear struct {
+	Addr                 string    `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Prev                 *Greeting `protobuf:"bytes,2,opt,name=prev,proto3" json:"prev,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ReceiptClear) Reset()         { *m = ReceiptClear{} }
+func (m *ReceiptClear) String() string { return proto.CompactTextString(m) }
+func (*ReceiptClear) ProtoMessage()    {}
+func (*ReceiptClear) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61ef911816e0a8ce, []int{5}
+}
+
+func (m *ReceiptClear) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptClear.Unmarshal(m, b)
+}
+func (m *ReceiptClear) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptClear.Marshal(b, m, deterministic)
+}
+func (m *ReceiptClear) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptClear.Merge(m, src)
+}
+func (m *ReceiptClear) XXX_Size() int {
+	return xxx_messageInfo_ReceiptClear.Size(m)
+}
+func (m *ReceiptClear) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptClear.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptClear proto.InternalMessageInfo
+
+func (m *ReceiptClear) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReceiptClear) GetPrev() *Greeting {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+// 地址问候次数统计, 存于localdb
+type GreetCount struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Count                int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GreetCount) Reset()         { *m = GreetCount{} }
+func (m *GreetCount) String() string { return proto.CompactTextString(m) }
+func (*GreetCount) ProtoMessage()    {}
+func (*GreetCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61ef911816e0a8ce, []int{6}
+}
+
+func (m *GreetCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GreetCount.Unmarshal(m, b)
+}
+func (m *GreetCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GreetCount.Marshal(b, m, deterministic)
+}
+func (m *GreetCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GreetCount.Merge(m, src)
+}
+func (m *GreetCount) XXX_Size() int {
+	return xxx_messageInfo_GreetCount.Size(m)
+}
+func (m *GreetCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_GreetCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GreetCount proto.InternalMessageInfo
+
+func (m *GreetCount) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *GreetCount) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ReqGreeting struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqGreeting) Reset()         { *m = ReqGreeting{} }
+func (m *ReqGreeting) String() string { return proto.CompactTextString(m) }
+func (*ReqGreeting) ProtoMessage()    {}
+func (*ReqGreeting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61ef911816e0a8ce, []int{7}
+}
+
+func (m *ReqGreeting) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqGreeting.Unmarshal(m, b)
+}
+func (m *ReqGreeting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqGreeting.Marshal(b, m, deterministic)
+}
+func (m *ReqGreeting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqGreeting.Merge(m, src)
+}
+func (m *ReqGreeting) XXX_Size() int {
+	return xxx_messageInfo_ReqGreeting.Size(m)
+}
+func (m *ReqGreeting) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqGreeting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqGreeting proto.InternalMessageInfo
+
+func (m *ReqGreeting) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+type ReplyGreeting struct {
+	Greeting             *Greeting `protobuf:"bytes,1,opt,name=greeting,proto3" json:"greeting,omitempty"`
+	Count                int64     `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ReplyGreeting) Reset()         { *m = ReplyGreeting{} }
+func (m *ReplyGreeting) String() string { return proto.CompactTextString(m) }
+func (*ReplyGreeting) ProtoMessage()    {}
+func (*ReplyGreeting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61ef911816e0a8ce, []int{8}
+}
+
+func (m *ReplyGreeting) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyGreeting.Unmarshal(m, b)
+}
+func (m *ReplyGreeting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyGreeting.Marshal(b, m, deterministic)
+}
+func (m *ReplyGreeting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyGreeting.Merge(m, src)
+}
+func (m *ReplyGreeting) XXX_Size() int {
+	return xxx_messageInfo_ReplyGreeting.Size(m)
+}
+func (m *ReplyGreeting) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyGreeting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyGreeting proto.InternalMessageInfo
+
+func (m *ReplyGreeting) GetGreeting() *Greeting {
+	if m != nil {
+		return m.Greeting
+	}
+	return nil
+}
+
+func (m *ReplyGreeting) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*HelloAction)(nil), "hello.HelloAction")
+	proto.RegisterType((*Greet)(nil), "hello.Greet")
+	proto.RegisterType((*Clear)(nil), "hello.Clear")
+	proto.RegisterType((*Greeting)(nil), "hello.Greeting")
+	proto.RegisterType((*ReceiptGreet)(nil), "hello.ReceiptGreet")
+	proto.RegisterType((*ReceiptClear)(nil), "hello.ReceiptClear")
+	proto.RegisterType((*GreetCount)(nil), "hello.GreetCount")
+	proto.RegisterType((*ReqGreeting)(nil), "hello.ReqGreeting")
+	proto.RegisterType((*ReplyGreeting)(nil), "hello.ReplyGreeting")
+}
+
+func init() { proto.RegisterFile("hello.proto", fileDescriptor_61ef911816e0a8ce) }
+
+var fileDescriptor_61ef911816e0a8ce = []byte{
+	// 322 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x41, 0x6b, 0xf2, 0x40,
+	0x10, 0xd5, 0xe8, 0xaa, 0xdf, 0xc4, 0xaf, 0x85, 0x45, 0x4a, 0xe8, 0x49, 0xb7, 0x3d, 0x08, 0x05,
+	0x0f, 0x16, 0x0a, 0x3d, 0x56, 0x0f, 0x7a, 0x2c, 0xfb, 0x0f, 0xd2, 0x38, 0xac, 0x81, 0x6d, 0x92,
+	0xae, 0x1b, 0x21, 0xff, 0xbe, 0xec, 0x64, 0x9b, 0x46, 0x1a, 0x7a, 0xe8, 0x6d, 0x66, 0xde, 0xdb,
+	0xf7, 0xde, 0x0e, 0x03, 0xe1, 0x11, 0xb5, 0xce, 0x57, 0x85, 0xc9, 0x6d, 0xce, 0x19, 0x35, 0xc2,
+	0x40, 0xb8, 0x77, 0xc5, 0x4b, 0x62, 0xd3, 0x3c, 0xe3, 0xf7, 0xc0, 0x94, 0x41, 0xb4, 0x51, 0x7f,
+	0xde, 0x5f, 0x86, 0xeb, 0xe9, 0xaa, 0x7e, 0xb2, 0x73, 0xb3, 0x7d, 0x4f, 0xd6, 0xa0, 0x63, 0x25,
+	0x1a, 0x63, 0x13, 0x05, 0x17, 0xac, 0xad, 0x9b, 0x39, 0x16, 0x81, 0xfc, 0x0a, 0x02, 0x5b, 0x45,
+	0x83, 0x79, 0x7f, 0xc9, 0x64, 0x60, 0xab, 0xcd, 0x18, 0xd8, 0x39, 0xd6, 0x25, 0x8a, 0x05, 0x30,
+	0x12, 0xe4, 0x11, 0x8c, 0xdf, 0xf1, 0x74, 0x8a, 0x15, 0x92, 0xdf, 0x3f, 0xf9, 0xd5, 0x8a, 0x31,
+	0x30, 0x52, 0x13, 0xaf, 0x30, 0x21, 0x6e, 0x9a, 0x29, 0xce, 0x61, 0x18, 0x1f, 0x0e, 0xc6, 0x73,
+	0xa9, 0x6e, 0x4b, 0x04, 0x17, 0x12, 0xfc, 0x06, 0x46, 0x47, 0x4c, 0xd5, 0xd1, 0x52, 0x84, 0x81,
+	0xf4, 0x9d, 0xd0, 0x30, 0x95, 0x98, 0x60, 0x5a, 0xd8, 0x3a, 0x44, 0x97, 0xea, 0x1d, 0x0c, 0x0b,
+	0x83, 0x67, 0xff, 0xbf, 0xeb, 0xf6, 0x16, 0xd2, 0x4c, 0x49, 0x02, 0x1d, 0x29, 0x29, 0x8d, 0x21,
+	0xf9, 0x2e, 0x92, 0x03, 0xc5, 0xae, 0x71, 0xa3, 0xff, 0xfc, 0xd9, 0x4d, 0x3c, 0x01, 0xd0, 0x64,
+	0x9b, 0x97, 0x59, 0x77, 0xe8, 0x19, 0xb0, 0xc4, 0x81, 0xa4, 0x33, 0x90, 0x75, 0x23, 0x16, 0x10,
+	0x4a, 0xfc, 0xf8, 0x6d, 0x87, 0x42, 0xc2, 0x7f, 0x89, 0x85, 0xae, 0x1a, 0xd2, 0x03, 0x4c, 0x94,
+	0xaf, 0xfd, 0x21, 0xfc, 0x08, 0xd5, 0x10, 0xba, 0x6d, 0xd7, 0x1b, 0xa8, 0x0f, 0x8c, 0x3f, 0x43,
+	0xb8, 0x43, 0xfb, 0xed, 0xef, 0x85, 0x5a, 0x99, 0x6e, 0x67, 0xcd, 0xac, 0x15, 0x42, 0xf4, 0xde,
+	0x46, 0x74, 0xa9, 0x8f, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x2c, 0xe6, 0x01, 0x71, 0xb8, 0x02,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// HelloClient is the client API for Hello service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type HelloClient interface {
+	GetGreeting(ctx context.Context, in *ReqGreeting, opts ...grpc.CallOption) (*ReplyGreeting, error)
+}
+
+type helloClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewHelloClient(cc *grpc.ClientConn) HelloClient {
+	return &helloClient{cc}
+}
+
+func (c *helloClient) GetGreeting(ctx context.Context, in *ReqGreeting, opts ...grpc.CallOption) (*ReplyGreeting, error) {
+	out := new(ReplyGreeting)
+	err := c.cc.Invoke(ctx, "/hello.hello/GetGreeting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HelloServer is the server API for Hello service.
+type HelloServer interface {
+	GetGreeting(context.Context, *ReqGreeting) (*ReplyGreeting, error)
+}
+
+func RegisterHelloServer(s *grpc.Server, srv HelloServer) {
+	s.RegisterService(&_Hello_serviceDesc, srv)
+}
+
+func _Hello_GetGreeting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqGreeting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HelloServer).GetGreeting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hello.hello/GetGreeting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HelloServer).GetGreeting(ctx, req.(*ReqGreeting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Hello_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "hello.hello",
+	HandlerType: (*HelloServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetGreeting",
+			Handler:    _Hello_GetGreeting_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hello.proto",
+}